@@ -1,10 +1,12 @@
 package main
 
 import (
+	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"strings"
 
 	"github.com/smnalex/cron/parser"
 )
@@ -19,8 +21,30 @@ func main() {
 	}
 
 	if p, err := parser.Parse(*cron); err != nil {
-		log.Printf("unable to parse input: %s", err.Error())
+		printParseError(*cron, err)
 	} else {
 		p.PrintTable(os.Stdout)
 	}
 }
+
+// printParseError reports err against input, underlining each offending
+// field with a caret at its Pos. It handles both the single-field
+// *parser.ParseError and the multi-field parser.ParseErrors that Parse
+// can return.
+func printParseError(input string, err error) {
+	var errs parser.ParseErrors
+	var pe *parser.ParseError
+	switch {
+	case errors.As(err, &errs):
+	case errors.As(err, &pe):
+		errs = parser.ParseErrors{pe}
+	default:
+		log.Printf("unable to parse input: %s", err.Error())
+		return
+	}
+
+	fmt.Fprintf(os.Stderr, "unable to parse input:\n%s\n", input)
+	for _, e := range errs {
+		fmt.Fprintf(os.Stderr, "%s^ %s field %q: %v\n", strings.Repeat(" ", e.Pos), e.Field, e.Expr, e.Cause)
+	}
+}
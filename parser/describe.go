@@ -0,0 +1,279 @@
+package parser
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// fieldKind classifies the bitset pattern produced by a parsed field, so
+// Describe can pick matching phrasing (e.g. "every 15 minutes" for a step,
+// "1 and 15" for a discrete list).
+type fieldKind int
+
+const (
+	kindFull fieldKind = iota
+	kindSingle
+	kindRange
+	kindStep
+	kindList
+)
+
+// classify inspects val against fr and reports its kind plus the values
+// set (ascending). For kindSingle and kindStep, value also holds the single
+// value or the step size respectively.
+func classify(val uint64, fr frame) (kind fieldKind, value uint8, bits []uint8) {
+	bits = bitsOf(val, fr)
+
+	switch {
+	case val == fullMask(fr):
+		return kindFull, 0, bits
+	case len(bits) == 1:
+		return kindSingle, bits[0], bits
+	case isContiguous(bits):
+		return kindRange, 0, bits
+	}
+
+	if step, ok := detectStep(val, bits, fr); ok {
+		return kindStep, step, bits
+	}
+	return kindList, 0, bits
+}
+
+func bitsOf(val uint64, fr frame) []uint8 {
+	var bits []uint8
+	for i := fr.min; i <= fr.max; i++ {
+		if (val>>i)&1 != 0 {
+			bits = append(bits, i)
+		}
+	}
+	return bits
+}
+
+func fullMask(fr frame) uint64 {
+	return (1<<(fr.max-fr.min+1) - 1) << fr.min
+}
+
+func isContiguous(bits []uint8) bool {
+	if len(bits) < 2 {
+		return false
+	}
+	return int(bits[len(bits)-1]-bits[0]) == len(bits)-1
+}
+
+// detectStep reports whether val is exactly the set produced by a "*/N"
+// expression over fr (i.e. starts at fr.min and advances by a constant
+// step through fr.max).
+func detectStep(val uint64, bits []uint8, fr frame) (step uint8, ok bool) {
+	if len(bits) < 2 || bits[0] != fr.min {
+		return 0, false
+	}
+	step = bits[1] - bits[0]
+	var acc uint64
+	for i := fr.min; i <= fr.max; i += step {
+		acc |= 1 << i
+	}
+	return step, acc == val
+}
+
+func joinValues(bits []uint8, format func(uint8) string) string {
+	names := make([]string, len(bits))
+	for i, b := range bits {
+		names[i] = format(b)
+	}
+	return joinEnglish(names)
+}
+
+// joinEnglish renders a list as "a", "a and b" or "a, b and c".
+func joinEnglish(items []string) string {
+	switch len(items) {
+	case 0:
+		return ""
+	case 1:
+		return items[0]
+	case 2:
+		return items[0] + " and " + items[1]
+	default:
+		return strings.Join(items[:len(items)-1], ", ") + " and " + items[len(items)-1]
+	}
+}
+
+func decimal(v uint8) string { return strconv.Itoa(int(v)) }
+
+// describeField renders a generic "day of month"/"month" style clause for
+// val, returning "" when the field is unrestricted ("*").
+func describeField(val uint64, fr frame, kind string) string {
+	k, v, bits := classify(val, fr)
+
+	name := func(b uint8) string {
+		if kind == "month" {
+			return monthName(b)
+		}
+		return decimal(b)
+	}
+
+	switch k {
+	case kindFull:
+		return ""
+	case kindSingle:
+		if kind == "month" {
+			return fmt.Sprintf("in %s", name(v))
+		}
+		return fmt.Sprintf("on day %s of the month", name(v))
+	case kindRange:
+		if kind == "month" {
+			return fmt.Sprintf("in %s through %s", name(bits[0]), name(bits[len(bits)-1]))
+		}
+		return fmt.Sprintf("on days %s through %s of the month", name(bits[0]), name(bits[len(bits)-1]))
+	case kindStep:
+		if kind == "month" {
+			return fmt.Sprintf("every %d months", v)
+		}
+		return fmt.Sprintf("every %d days", v)
+	default:
+		if kind == "month" {
+			return fmt.Sprintf("in %s", joinValues(bits, name))
+		}
+		return fmt.Sprintf("on day %s of the month", joinValues(bits, name))
+	}
+}
+
+var monthNamesFull = map[uint8]string{
+	1: "January", 2: "February", 3: "March", 4: "April", 5: "May", 6: "June",
+	7: "July", 8: "August", 9: "September", 10: "October", 11: "November", 12: "December",
+}
+
+func monthName(m uint8) string {
+	if name, ok := monthNamesFull[m]; ok {
+		return name
+	}
+	return decimal(m)
+}
+
+var weekdayNamesFull = map[uint8]string{
+	0: "Sunday", 1: "Monday", 2: "Tuesday", 3: "Wednesday", 4: "Thursday", 5: "Friday", 6: "Saturday",
+}
+
+func weekdayName(d uint8) string {
+	if d == 7 {
+		d = 0
+	}
+	if name, ok := weekdayNamesFull[d]; ok {
+		return name
+	}
+	return decimal(d)
+}
+
+// describeDayOfWeek renders the weekday clause: "" for a full week, bare
+// (no "on") for a contiguous range, and "on ..." for a single day or list.
+func describeDayOfWeek(val uint64) string {
+	// classify against a 0-6 frame, matching the value already folded by
+	// normalizeDayOfWeek, so a "*" (bits 0-7 set) folds to exactly this
+	// frame's full mask instead of one bit short of frames["dayOfWeek"]'s
+	// 0-7 range.
+	fr := frame{min: 0, max: 6}
+	k, v, bits := classify(normalizeDayOfWeek(val), fr)
+
+	switch k {
+	case kindFull:
+		return ""
+	case kindSingle:
+		return fmt.Sprintf("on %s", weekdayName(v))
+	case kindRange:
+		return fmt.Sprintf("%s through %s", weekdayName(bits[0]), weekdayName(bits[len(bits)-1]))
+	default:
+		return fmt.Sprintf("on %s", joinValues(bits, weekdayName))
+	}
+}
+
+// normalizeDayOfWeek folds bit 7 (an alias for Sunday) onto bit 0 so
+// classify sees a single canonical representation.
+func normalizeDayOfWeek(val uint64) uint64 {
+	if val&(1<<7) != 0 {
+		val = val&^(1<<7) | 1<<0
+	}
+	return val
+}
+
+func clockTime(hour, minute uint8) string {
+	period := "AM"
+	h := int(hour)
+	switch {
+	case h == 0:
+		h = 12
+	case h == 12:
+		period = "PM"
+	case h > 12:
+		h -= 12
+		period = "PM"
+	}
+	return fmt.Sprintf("%d:%02d %s", h, minute, period)
+}
+
+// describeTimeOfDay renders the minute/hour portion of Describe: a single
+// "at H:MM AM/PM" clause when both fields pin down one instant, a single
+// "every minute" when neither is restricted, otherwise a minute clause
+// followed by an hour clause (omitted when every hour matches).
+func describeTimeOfDay(minutes, hours uint64) []string {
+	mKind, mVal, mBits := classify(minutes, frames["minute"])
+	hKind, hVal, hBits := classify(hours, frames["hour"])
+
+	if mKind == kindSingle && hKind == kindSingle {
+		return []string{fmt.Sprintf("at %s", clockTime(hVal, mVal))}
+	}
+	if mKind == kindFull && hKind == kindFull {
+		return []string{"every minute"}
+	}
+
+	var clauses []string
+	switch mKind {
+	case kindFull:
+		clauses = append(clauses, "every minute")
+	case kindStep:
+		clauses = append(clauses, fmt.Sprintf("every %d minutes", mVal))
+	case kindSingle:
+		clauses = append(clauses, fmt.Sprintf("at minute %d", mVal))
+	case kindRange:
+		clauses = append(clauses, fmt.Sprintf("at minutes %d through %d", mBits[0], mBits[len(mBits)-1]))
+	case kindList:
+		clauses = append(clauses, fmt.Sprintf("at minutes %s", joinValues(mBits, decimal)))
+	}
+
+	switch hKind {
+	case kindFull:
+		// every hour; no clause needed
+	case kindSingle:
+		clauses = append(clauses, fmt.Sprintf("at %s", clockTime(hVal, 0)))
+	case kindStep:
+		clauses = append(clauses, fmt.Sprintf("every %d hours", hVal))
+	case kindRange:
+		clauses = append(clauses, fmt.Sprintf("from %s to %s", clockTime(hBits[0], 0), clockTime(hBits[len(hBits)-1], 0)))
+	case kindList:
+		clauses = append(clauses, fmt.Sprintf("at hours %s", joinValues(hBits, decimal)))
+	}
+	return clauses
+}
+
+// Describe renders a plain-English summary of a parsed expression, e.g.
+// "*/15 0 1,15 * 1-5" -> "Every 15 minutes, at 12:00 AM, on day 1 and 15
+// of the month, Monday through Friday".
+func (s *Schedule) Describe() string {
+	var clauses []string
+	clauses = append(clauses, describeTimeOfDay(s.Minutes, s.Hours)...)
+
+	if dom := describeField(s.DaysOfMonth, frames["dayOfMonth"], "dayOfMonth"); dom != "" {
+		clauses = append(clauses, dom)
+	}
+	if dow := describeDayOfWeek(s.DaysOfWeek); dow != "" {
+		clauses = append(clauses, dow)
+	}
+	if mon := describeField(s.Months, frames["month"], "month"); mon != "" {
+		clauses = append(clauses, mon)
+	}
+
+	joined := strings.Join(clauses, ", ")
+	if joined == "" {
+		return ""
+	}
+	return strings.ToUpper(joined[:1]) + joined[1:]
+}
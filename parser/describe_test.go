@@ -0,0 +1,48 @@
+package parser
+
+import "testing"
+
+func TestScheduleDescribe(t *testing.T) {
+	tt := []struct {
+		expr string
+		exp  string
+	}{
+		{"*/15 0 1,15 * 1-5", "Every 15 minutes, at 12:00 AM, on day 1 and 15 of the month, Monday through Friday"},
+		{"* * * * *", "Every minute"},
+		{"0 0 * * *", "At 12:00 AM"},
+		{"30 14 * * *", "At 2:30 PM"},
+		{"0 9-17 * * *", "At minute 0, from 9:00 AM to 5:00 PM"},
+		{"*/5 * * * *", "Every 5 minutes"},
+		{"0 0 1 * *", "At 12:00 AM, on day 1 of the month"},
+		{"0 0 * * 0", "At 12:00 AM, on Sunday"},
+		{"0 0 * * 6,0", "At 12:00 AM, on Sunday and Saturday"},
+		{"0 12 1 1 *", "At 12:00 PM, on day 1 of the month, in January"},
+		{"0 0 1,15 * *", "At 12:00 AM, on day 1 and 15 of the month"},
+		{"0 0 */10 * *", "At 12:00 AM, every 10 days"},
+		{"0 */6 * * *", "At minute 0, every 6 hours"},
+		{"15,45 * * * *", "At minutes 15 and 45"},
+		{"0 0 29 2 *", "At 12:00 AM, on day 29 of the month, in February"},
+		{"0 6,18 * * *", "At minute 0, at hours 6 and 18"},
+		{"0 0 * 1-3 *", "At 12:00 AM, in January through March"},
+		{"* 9 * * *", "Every minute, at 9:00 AM"},
+		{"0 0 * * 1,3,5", "At 12:00 AM, on Monday, Wednesday and Friday"},
+		{"0 0 1 */3 *", "At 12:00 AM, on day 1 of the month, every 3 months"},
+		{"59 23 * * *", "At 11:59 PM"},
+		{"0 0 31 12 *", "At 12:00 AM, on day 31 of the month, in December"},
+		{"*/20 * * * *", "Every 20 minutes"},
+		{"0 1,13 * * *", "At minute 0, at hours 1 and 13"},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.expr, func(t *testing.T) {
+			sch, err := Parse(tc.expr + " cmd")
+			if err != nil {
+				t.Fatalf("Parse(%q) failed: %v", tc.expr, err)
+			}
+			got := sch.Describe()
+			if got != tc.exp {
+				t.Errorf("Describe(%q)\nexp %q\ngot %q", tc.expr, tc.exp, got)
+			}
+		})
+	}
+}
@@ -0,0 +1,44 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ParseError identifies a single malformed field in a Parse input: which
+// field it was, the offending text, and its byte offset into the original
+// input, so a caller can underline the bad column.
+type ParseError struct {
+	Field string
+	Expr  string
+	Pos   int
+	Cause error
+}
+
+func (e *ParseError) Error() string {
+	return fmt.Sprintf("%s field %q at byte %d: %v", e.Field, e.Expr, e.Pos, e.Cause)
+}
+
+func (e *ParseError) Unwrap() error { return e.Cause }
+
+// ParseErrors aggregates every field error found while parsing a single
+// input; Parse returns one of these instead of stopping at the first bad
+// field, so a caller can report all of them at once.
+type ParseErrors []*ParseError
+
+func (pe ParseErrors) Error() string {
+	msgs := make([]string, len(pe))
+	for i, e := range pe {
+		msgs[i] = e.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Unwrap exposes the individual *ParseError values to errors.Is/errors.As.
+func (pe ParseErrors) Unwrap() []error {
+	errs := make([]error, len(pe))
+	for i, e := range pe {
+		errs[i] = e
+	}
+	return errs
+}
@@ -0,0 +1,58 @@
+package parser
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestParseFieldErrors(t *testing.T) {
+	tt := []struct {
+		inp      string
+		expField string
+		expPos   int
+	}{
+		{"a * * * * cmd", "minute", 0},
+		{"* */3 1,-2 * * cmd", "dayOfMonth", 8},
+		{"* */3 1-2 9- * cmd", "month", 10},
+		{"* */3 1-2 9 8 cmd", "dayOfWeek", 12},
+		{"", "command", 0},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.inp, func(t *testing.T) {
+			_, err := Parse(tc.inp)
+			if err == nil {
+				t.Fatalf("%q: exp err got nil", tc.inp)
+			}
+
+			var pe *ParseError
+			if !errors.As(err, &pe) {
+				t.Fatalf("%q: exp errors.As to recover a *ParseError, got %T", tc.inp, err)
+			}
+			if pe.Field != tc.expField {
+				t.Errorf("%q: exp field %q got %q", tc.inp, tc.expField, pe.Field)
+			}
+			if pe.Pos != tc.expPos {
+				t.Errorf("%q: exp pos %d got %d", tc.inp, tc.expPos, pe.Pos)
+			}
+			if pe.Cause == nil {
+				t.Errorf("%q: exp non-nil Cause", tc.inp)
+			}
+		})
+	}
+}
+
+func TestParseErrorsCollectsEveryField(t *testing.T) {
+	_, err := Parse("a b * * * cmd")
+
+	var errs ParseErrors
+	if !errors.As(err, &errs) {
+		t.Fatalf("exp errors.As to recover ParseErrors, got %T", err)
+	}
+	if len(errs) != 2 {
+		t.Fatalf("exp 2 field errors, got %d: %v", len(errs), errs)
+	}
+	if errs[0].Field != "minute" || errs[1].Field != "hour" {
+		t.Errorf("exp minute then hour, got %q then %q", errs[0].Field, errs[1].Field)
+	}
+}
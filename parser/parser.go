@@ -0,0 +1,438 @@
+package parser
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/pkg/errors"
+)
+
+type frame struct {
+	min, max uint8
+	// names holds optional symbolic aliases for a field (e.g. "jan" -> 1),
+	// looked up case-insensitively when a token isn't a plain number.
+	names map[string]uint8
+}
+
+// monthNames are the crontab(5) aliases for the month field.
+var monthNames = map[string]uint8{
+	"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+	"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+}
+
+// dayOfWeekNames are the crontab(5) aliases for the day-of-week field;
+// 7 is still accepted numerically as an alias for Sunday.
+var dayOfWeekNames = map[string]uint8{
+	"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+}
+
+var frames = map[string]frame{
+	"second":     frame{min: 0, max: 59},
+	"minute":     frame{min: 0, max: 59},
+	"hour":       frame{min: 0, max: 23},
+	"dayOfMonth": frame{min: 1, max: 31},
+	"month":      frame{min: 1, max: 12, names: monthNames},
+	"dayOfWeek":  frame{min: 0, max: 7, names: dayOfWeekNames},
+}
+
+// descriptors maps the predefined shorthands to their expanded 6-field
+// (seconds-first) form, following the crontab(5) convention.
+var descriptors = map[string]string{
+	"@yearly":   "0 0 0 1 1 *",
+	"@annually": "0 0 0 1 1 *",
+	"@monthly":  "0 0 0 1 * *",
+	"@weekly":   "0 0 0 * * 0",
+	"@daily":    "0 0 0 * * *",
+	"@midnight": "0 0 0 * * *",
+	"@hourly":   "0 0 * * * *",
+}
+
+// Schedule stores times of execution for each field and a command
+type Schedule struct {
+	Seconds     uint64
+	Minutes     uint64
+	Hours       uint64
+	DaysOfMonth uint64
+	Months      uint64
+	DaysOfWeek  uint64
+	// Every holds the interval for a "@every <duration>" schedule; zero
+	// for ordinary field-based schedules.
+	Every   time.Duration
+	Command string
+
+	// SecondsStar, MinutesStar, ... record whether the corresponding
+	// field in the source expression was the literal "*", rather than a
+	// list or step. Next/Prev use DaysOfMonthStar/DaysOfWeekStar to
+	// apply the standard cron OR-semantics between those two fields.
+	SecondsStar     bool
+	MinutesStar     bool
+	HoursStar       bool
+	DaysOfMonthStar bool
+	MonthsStar      bool
+	DaysOfWeekStar  bool
+
+	// Location pins Next/Prev evaluation to a timezone; nil means
+	// time.Local.
+	Location *time.Location
+}
+
+// ParseOptions controls the dialect accepted by ParseWithOptions.
+type ParseOptions struct {
+	// Seconds, when true, expects a leading seconds column so the input
+	// becomes "Second Minute Hour Day Month Weekday Command".
+	Seconds bool
+	// AllowDescriptors, when true, accepts the predefined shorthands
+	// (@yearly, @monthly, @weekly, @daily/@midnight, @hourly, @every
+	// <duration>) as a full-line replacement for the field list.
+	AllowDescriptors bool
+}
+
+// Parse parses a string input, return a Schedule type and stops on invalid input
+// by returning an error, accepted input "Minute Hour Day of Month Month Day of Week"
+// [] 0/1; {} 0+; | OR
+// Line = {Spaces} List Spaces List Spaces List Spaces List Spaces List Spaces Command .
+//                  minute      hour        dom         month       dow
+// List  = Range { "," Range }.
+// Range = "*" ["/" Number] | Number ["-" Number] ["/" Number].
+//
+// Parse always uses the 5-field dialect; use ParseWithOptions to opt into
+// a leading seconds column or the @-prefixed descriptors.
+func Parse(seq string) (*Schedule, error) {
+	return parse(seq, false)
+}
+
+// ParseWithOptions parses seq under the dialect selected by opts. With
+// opts.AllowDescriptors, a line starting with "@" is matched against the
+// predefined shorthands before falling back to field parsing.
+func ParseWithOptions(seq string, opts ParseOptions) (*Schedule, error) {
+	if opts.AllowDescriptors {
+		if sch, ok, err := parseDescriptor(seq); ok {
+			return sch, err
+		}
+	}
+	return parse(seq, opts.Seconds)
+}
+
+// nextField extracts the next field from seq (tracking *pos as the byte
+// offset of that field within the original input) and reports its own
+// start offset alongside its text.
+func nextField(seq *string, pos *int) (tok string, tokPos int) {
+	trimmed := strings.TrimLeftFunc(*seq, tabSpaceFn)
+	*pos += len(*seq) - len(trimmed)
+
+	var rest string
+	rest, tok = extractField(trimmed)
+	tokPos = *pos
+	*pos += len(tok)
+	*seq = rest
+	return tok, tokPos
+}
+
+func parse(seq string, seconds bool) (*Schedule, error) {
+	pos := 0
+
+	var secondsField string
+	var secondsPos int
+	if seconds {
+		secondsField, secondsPos = nextField(&seq, &pos)
+	}
+	minutes, minutesPos := nextField(&seq, &pos)
+	hours, hoursPos := nextField(&seq, &pos)
+	daysOfMounth, domPos := nextField(&seq, &pos)
+	months, monthsPos := nextField(&seq, &pos)
+	daysOfWeek, dowPos := nextField(&seq, &pos)
+
+	if seq == "" {
+		return nil, &ParseError{Field: "command", Pos: pos, Cause: errors.New("invalid sequance, expecting Minute Hour Day Month Command")}
+	}
+	command := strings.TrimLeftFunc(seq, tabSpaceFn)
+
+	efp := &errFieldParser{}
+	sch := &Schedule{
+		Minutes:         efp.parseField(minutes, "minute", minutesPos),
+		Hours:           efp.parseField(hours, "hour", hoursPos),
+		DaysOfMonth:     efp.parseField(daysOfMounth, "dayOfMonth", domPos),
+		Months:          efp.parseField(months, "month", monthsPos),
+		DaysOfWeek:      efp.parseField(daysOfWeek, "dayOfWeek", dowPos),
+		Command:         command,
+		MinutesStar:     minutes == "*",
+		HoursStar:       hours == "*",
+		DaysOfMonthStar: daysOfMounth == "*",
+		MonthsStar:      months == "*",
+		DaysOfWeekStar:  daysOfWeek == "*",
+	}
+	if seconds {
+		sch.Seconds = efp.parseField(secondsField, "second", secondsPos)
+		sch.SecondsStar = secondsField == "*"
+	}
+
+	if err := efp.err(); err != nil {
+		return nil, err
+	}
+
+	return sch, nil
+}
+
+// parseDescriptor reports ok=false when seq does not start with "@", so the
+// caller can fall back to ordinary field parsing.
+func parseDescriptor(seq string) (sch *Schedule, ok bool, err error) {
+	trimmed := strings.TrimLeftFunc(seq, tabSpaceFn)
+	if !strings.HasPrefix(trimmed, "@") {
+		return nil, false, nil
+	}
+	rest, name := extractField(trimmed)
+
+	if strings.EqualFold(name, "@every") {
+		rest, durStr := extractField(rest)
+		dur, err := time.ParseDuration(durStr)
+		if err != nil {
+			return nil, true, errors.Wrapf(err, "invalid duration %q in @every", durStr)
+		}
+		if dur <= 0 {
+			return nil, true, errors.Errorf("duration %q in @every must be positive", durStr)
+		}
+		command := strings.TrimLeftFunc(rest, tabSpaceFn)
+		return &Schedule{Every: dur, Command: command}, true, nil
+	}
+
+	expr, found := descriptors[strings.ToLower(name)]
+	if !found {
+		return nil, true, errors.Errorf("unrecognized descriptor %q", name)
+	}
+	command := strings.TrimLeftFunc(rest, tabSpaceFn)
+	sch, err = parse(expr+" "+command, true)
+	return sch, true, err
+}
+
+// errFieldParser parses every field even after one fails, collecting a
+// *ParseError per bad field so Parse can report all of them at once
+// instead of stopping at the first.
+type errFieldParser struct{ errs []*ParseError }
+
+func (e *errFieldParser) parseField(s, field string, pos int) (val uint64) {
+	val, err := parseList(s, frames[field])
+	if err != nil {
+		expr, cause := s, err
+		if pe, ok := err.(*ParseError); ok {
+			expr, cause = pe.Expr, pe.Cause
+			if idx := strings.Index(s, pe.Expr); idx >= 0 {
+				pos += idx
+			}
+		}
+		e.errs = append(e.errs, &ParseError{Field: field, Expr: expr, Pos: pos, Cause: cause})
+	}
+	return val
+}
+
+func (e *errFieldParser) err() error {
+	if len(e.errs) == 0 {
+		return nil
+	}
+	return ParseErrors(e.errs)
+}
+
+var tabSpaceFn = func(r rune) bool { return r == ' ' || r == '\t' }
+
+func extractField(s string) (string, string) {
+	s = strings.TrimLeftFunc(s, tabSpaceFn)
+
+	idx := 0
+	for idx < len(s) && !unicode.IsSpace(rune(s[idx])) {
+		idx++
+	}
+	return s[idx:], s[:idx]
+}
+
+// PrintTable outputs a table of all supported types
+func (s *Schedule) PrintTable(w io.Writer) {
+	fmt.Fprintf(w, "%s\n", s.PrintMinutes())
+	fmt.Fprintf(w, "%s\n", s.PrintHours())
+	fmt.Fprintf(w, "%s\n", s.PrintDaysOfMonth())
+	fmt.Fprintf(w, "%s\n", s.PrintMonths())
+	fmt.Fprintf(w, "%s\n", s.PrintDaysOfWeek())
+	fmt.Fprintf(w, "%s\n", s.PrintCommand())
+}
+
+// PrintSeconds outputs the list of seconds with `second`
+// as a prefix; ranges from 0-59
+func (s *Schedule) PrintSeconds() string {
+	return prefixPrint("second", s.Seconds, frames["second"])
+}
+
+// PrintMinutes outputs the list of minutes with `minute`
+// as a prefix, ranges from 0-59
+func (s *Schedule) PrintMinutes() string {
+	return prefixPrint("minute", s.Minutes, frames["minute"])
+}
+
+// PrintHours outputs the list of hours with `hour`
+// as a prefix; ranges from 0-23
+func (s *Schedule) PrintHours() string {
+	return prefixPrint("hour", s.Hours, frames["hour"])
+}
+
+// PrintDaysOfMonth outputs the list of days with `day of month`
+// as a prefix; ranges from 1-31
+func (s *Schedule) PrintDaysOfMonth() string {
+	return prefixPrint("day of month", s.DaysOfMonth, frames["dayOfMonth"])
+}
+
+// PrintMonths outputs the list of days with `day of month`
+// as a prefix; ranges from 1-12
+func (s *Schedule) PrintMonths() string {
+	return prefixPrint("month", s.Months, frames["month"])
+}
+
+// PrintDaysOfWeek outputs the list of days with `day of week`
+// as a prefix; ranges from 0-7
+func (s *Schedule) PrintDaysOfWeek() string {
+	return prefixPrint("day of week", s.DaysOfWeek, frames["dayOfWeek"])
+}
+
+// PrintCommand outputs the specified command with `command` as prefix
+func (s *Schedule) PrintCommand() string {
+	return fmt.Sprintf("%-14s %s", "command", s.Command)
+}
+
+func prefixPrint(prefix string, val uint64, fr frame) string {
+	var b strings.Builder
+	b.WriteString(fmt.Sprintf("%-14s", prefix))
+	print(&b, val, fr)
+	return b.String()
+}
+
+func print(b *strings.Builder, val uint64, fr frame) {
+	for i := fr.min; i <= fr.max; i++ {
+		if (val>>i)&1 != 0 {
+			b.WriteString(fmt.Sprintf(" %d", i))
+		}
+	}
+}
+
+func parseList(s string, fr frame) (uint64, error) {
+	var acc uint64
+	fields := strings.Split(s, ",")
+	for _, field := range fields {
+		res, err := parseExp(field, fr)
+		if err != nil {
+			return 0, err
+		}
+		acc |= res
+	}
+	return acc, nil
+}
+
+func parseExp(s string, fr frame) (uint64, error) {
+	if s == "" {
+		return 0, &ParseError{Expr: s, Cause: errors.New("empty sequance")}
+	}
+
+	seqs := strings.Split(s, "/")
+	rng := strings.Split(seqs[0], "-")
+	if len(rng) == 0 || len(rng) > 2 || rng[0] == "" {
+		return 0, &ParseError{Expr: s, Cause: errors.Errorf("not supported chars in %v", s)}
+	}
+
+	var err error
+	var from, to, inc uint8
+	if len(rng) == 1 {
+		if rng[0] == "*" {
+			from, to = fr.min, fr.max
+		} else {
+			from, err = resolveToken(rng[0], fr)
+			if err != nil {
+				return 0, err
+			}
+			to = from
+		}
+	} else if len(rng) == 2 {
+		from, err = resolveToken(rng[0], fr)
+		if err != nil {
+			return 0, err
+		}
+		to, err = resolveToken(rng[1], fr)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if len(seqs) == 1 {
+		inc = 1
+	} else if len(seqs) == 2 {
+		inc, err = parseInt(seqs[1])
+		if err != nil {
+			return 0, err
+		}
+	}
+	return fillSet(from, to, inc, fr)
+}
+
+func fillSet(from, to, inc uint8, fr frame) (uint64, error) {
+	var acc uint64
+
+	if from < fr.min || fr.max < from {
+		return acc, &ParseError{Expr: strconv.Itoa(int(from)), Cause: errors.Errorf("out of range %v", from)}
+	}
+	if to < fr.min || fr.max < to {
+		return acc, &ParseError{Expr: strconv.Itoa(int(to)), Cause: errors.Errorf("out of range %v", to)}
+	}
+	if inc == 0 {
+		return acc, &ParseError{Expr: "0", Cause: errors.New("step needs to be > then 0")}
+	}
+
+	if to < from {
+		acc, err := fillSet(from, fr.max, inc, fr)
+		if err != nil {
+			return 0, err
+		}
+		newAcc, err := fillSet(fr.min, to, inc, fr)
+		if err != nil {
+			return 0, err
+		}
+		return acc | newAcc, nil
+	}
+
+	if inc == 1 {
+		acc = (1<<(to-from+1) - 1) << from
+	} else {
+		for i := from; i <= to; i += inc {
+			acc |= 1 << i
+		}
+	}
+	return acc, nil
+}
+
+// resolveToken parses s as a plain number, falling back to fr.names (a
+// case-insensitive lookup) for symbolic month/weekday aliases such as
+// "jan" or "mon".
+func resolveToken(s string, fr frame) (uint8, error) {
+	if v, err := parseInt(s); err == nil {
+		return v, nil
+	}
+	if fr.names != nil {
+		if v, ok := fr.names[strings.ToLower(s)]; ok {
+			return v, nil
+		}
+	}
+	return 0, &ParseError{Expr: s, Cause: errors.Errorf("invalid token %q", s)}
+}
+
+const iNF uint8 = 100
+
+func parseInt(s string) (uint8, error) {
+	var acc uint8
+	for i, r := range s {
+		if r < '0' || '9' < r {
+			return 0, &ParseError{Expr: s, Cause: errors.Errorf("invalid digit %v", s)}
+		}
+		acc = acc*10 + s[i] - '0'
+	}
+	if acc > iNF {
+		acc = iNF
+	}
+	return acc, nil
+}
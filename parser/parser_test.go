@@ -7,55 +7,56 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+	"time"
 )
 
 // Supported values and formats
 func TestParseList(t *testing.T) {
 	// Supported
 	t.Run("anyValue set first bit", th(parseListReq("*", frame{}), 1<<0, false))
-	t.Run("list set first bit", th(parseListReq("0", frame{0, 1}), 1<<0, false))
-	t.Run("list set multiple bits", th(parseListReq("0,1", frame{0, 1}), 1<<0|1<<1, false))
-	t.Run("range set multiple bits", th(parseListReq("1-2", frame{1, 2}), 1<<1|1<<2, false))
-	t.Run("anyValue with step", th(parseListReq("*/2", frame{0, 3}), 1<<0|1<<2, false))
-	t.Run("range with step", th(parseListReq("0-5/2", frame{0, 9}), 1<<0|1<<2|1<<4, false))
-	t.Run("range with step and offset", th(parseListReq("1-5/2", frame{0, 9}), 1<<1|1<<3|1<<5, false))
-	t.Run("range with step > frame.max", th(parseListReq("1-5/20", frame{0, 9}), 1<<1, false))
-	t.Run("list, range, list", th(parseListReq("4,3-5,2", frame{2, 5}), 1<<4|1<<3|1<<5|1<<2, false))
-	t.Run("zero step", th(parseListReq("*/0", frame{0, 3}), 0, false))
-	t.Run("reversed range", th(parseListReq("3-1", frame{0, 3}), 1<<0|1<<1|1<<3, false))
-	t.Run("reversed range with step", th(parseListReq("3-1/2", frame{0, 3}), 1<<0|1<<3, false))
+	t.Run("list set first bit", th(parseListReq("0", frame{min: 0, max: 1}), 1<<0, false))
+	t.Run("list set multiple bits", th(parseListReq("0,1", frame{min: 0, max: 1}), 1<<0|1<<1, false))
+	t.Run("range set multiple bits", th(parseListReq("1-2", frame{min: 1, max: 2}), 1<<1|1<<2, false))
+	t.Run("anyValue with step", th(parseListReq("*/2", frame{min: 0, max: 3}), 1<<0|1<<2, false))
+	t.Run("range with step", th(parseListReq("0-5/2", frame{min: 0, max: 9}), 1<<0|1<<2|1<<4, false))
+	t.Run("range with step and offset", th(parseListReq("1-5/2", frame{min: 0, max: 9}), 1<<1|1<<3|1<<5, false))
+	t.Run("range with step > frame.max", th(parseListReq("1-5/20", frame{min: 0, max: 9}), 1<<1, false))
+	t.Run("list, range, list", th(parseListReq("4,3-5,2", frame{min: 2, max: 5}), 1<<4|1<<3|1<<5|1<<2, false))
+	t.Run("zero step", th(parseListReq("*/0", frame{min: 0, max: 3}), 0, false))
+	t.Run("reversed range", th(parseListReq("3-1", frame{min: 0, max: 3}), 1<<0|1<<1|1<<3, false))
+	t.Run("reversed range with step", th(parseListReq("3-1/2", frame{min: 0, max: 3}), 1<<0|1<<3, false))
 
 	// Not supported
-	t.Run("empty value", th(parseListReq("", frame{0, 3}), 0, true))
-	t.Run("invalid character", th(parseListReq("i", frame{0, 3}), 0, true))
+	t.Run("empty value", th(parseListReq("", frame{min: 0, max: 3}), 0, true))
+	t.Run("invalid character", th(parseListReq("i", frame{min: 0, max: 3}), 0, true))
 	t.Run("list values out of frame", th(parseListReq("1,2", frame{}), 0, true))
 	t.Run("range values out of frame", th(parseListReq("1-2", frame{}), 0, true))
-	t.Run("invalid range format", th(parseListReq("1-2-3", frame{0, 3}), 0, true))
-	t.Run("incomplete range format", th(parseListReq("-1", frame{0, 3}), 0, true))
-	t.Run("invalid chars in before step", th(parseListReq("p/1", frame{0, 3}), 0, true))
-	t.Run("invalid chars in step", th(parseListReq("1/p", frame{0, 3}), 0, true))
-	t.Run("invalid chars in range from", th(parseListReq("p-1", frame{0, 3}), 0, true))
-	t.Run("invalid chars in range to", th(parseListReq("1-p", frame{0, 3}), 0, true))
-	t.Run("invalid chars in range with step", th(parseListReq("1-p/4", frame{0, 3}), 0, true))
-	t.Run("invalid chars in step with range", th(parseListReq("1-2/p", frame{0, 3}), 0, true))
+	t.Run("invalid range format", th(parseListReq("1-2-3", frame{min: 0, max: 3}), 0, true))
+	t.Run("incomplete range format", th(parseListReq("-1", frame{min: 0, max: 3}), 0, true))
+	t.Run("invalid chars in before step", th(parseListReq("p/1", frame{min: 0, max: 3}), 0, true))
+	t.Run("invalid chars in step", th(parseListReq("1/p", frame{min: 0, max: 3}), 0, true))
+	t.Run("invalid chars in range from", th(parseListReq("p-1", frame{min: 0, max: 3}), 0, true))
+	t.Run("invalid chars in range to", th(parseListReq("1-p", frame{min: 0, max: 3}), 0, true))
+	t.Run("invalid chars in range with step", th(parseListReq("1-p/4", frame{min: 0, max: 3}), 0, true))
+	t.Run("invalid chars in step with range", th(parseListReq("1-2/p", frame{min: 0, max: 3}), 0, true))
 
 	// Reset step to 100
-	t.Run("reset step to 100", th(parseListReq("*/1000", frame{0, 0}), 1, false))
+	t.Run("reset step to 100", th(parseListReq("*/1000", frame{min: 0, max: 0}), 1, false))
 }
 
 func TestAround(t *testing.T) {
 	// 5-2 -> 5 6 7 0 1 2
-	t.Run("non-standard step format", th(parseListReq("5-2", frame{0, 7}), 1<<0|1<<1|1<<2|1<<5|1<<6|1<<7, false))
-	t.Run("non-standard step format", th(parseListReq("5-2", frame{0, 3}), 0, true))
+	t.Run("non-standard step format", th(parseListReq("5-2", frame{min: 0, max: 7}), 1<<0|1<<1|1<<2|1<<5|1<<6|1<<7, false))
+	t.Run("non-standard step format", th(parseListReq("5-2", frame{min: 0, max: 3}), 0, true))
 }
 
 func TestNonStandard(t *testing.T) {
 	// 0 1 2 3
 	//   1
-	t.Run("non-standard step format", th(parseListReq("1/3", frame{0, 3}), 1<<1, false))
+	t.Run("non-standard step format", th(parseListReq("1/3", frame{min: 0, max: 3}), 1<<1, false))
 	// 0 1 2 3
 	//   1 1 1
-	t.Run("non-standard step with list", th(parseListReq("1,2,3/8", frame{0, 3}), 1<<1|1<<2|1<<3, false))
+	t.Run("non-standard step with list", th(parseListReq("1,2,3/8", frame{min: 0, max: 3}), 1<<1|1<<2|1<<3, false))
 }
 
 func TestFieldsFrame(t *testing.T) {
@@ -106,6 +107,7 @@ func TestParse(t *testing.T) {
 			Months:      (1<<(12) - 1) << 1,
 			DaysOfWeek:  1<<1 | 1<<2 | 1<<3 | 1<<4 | 1<<5,
 			Command:     "/usr/bin/find",
+			MonthsStar:  true,
 		}
 		got, err := Parse(inp)
 
@@ -151,6 +153,162 @@ func TestParse(t *testing.T) {
 	})
 }
 
+func TestNameAliases(t *testing.T) {
+	t.Run("month names", func(t *testing.T) {
+		fr := frames["month"]
+		t.Run("single name", th(parseListReq("jan", fr), 1<<1, false))
+		t.Run("case-insensitive", th(parseListReq("JaN", fr), 1<<1, false))
+		t.Run("range of names", th(parseListReq("jan-mar", fr), 1<<1|1<<2|1<<3, false))
+		t.Run("range of names with step", th(parseListReq("jan-mar/1", fr), 1<<1|1<<2|1<<3, false))
+		t.Run("name mixed with number", th(parseListReq("jan,3", fr), 1<<1|1<<3, false))
+		t.Run("misspelled name", th(parseListReq("jaan", fr), 0, true))
+	})
+
+	t.Run("day of week names", func(t *testing.T) {
+		fr := frames["dayOfWeek"]
+		t.Run("single name", th(parseListReq("mon", fr), 1<<1, false))
+		t.Run("range of names", th(parseListReq("mon-fri", fr), 1<<1|1<<2|1<<3|1<<4|1<<5, false))
+		t.Run("numeric 7 still means Sunday", th(parseListReq("7", fr), 1<<7, false))
+		t.Run("misspelled name", th(parseListReq("sunday", fr), 0, true))
+	})
+
+	t.Run("day of month has no names", func(t *testing.T) {
+		fr := frames["dayOfMonth"]
+		t.Run("name rejected", th(parseListReq("mon", fr), 0, true))
+	})
+
+	t.Run("Parse accepts names end to end", func(t *testing.T) {
+		got, err := Parse("0 0 1 jan-mar mon-fri cmd")
+		if err != nil {
+			t.Fatalf("exp no err got %v", err)
+		}
+		if got.Months != 1<<1|1<<2|1<<3 {
+			t.Errorf("exp months 1-3, got %b", got.Months)
+		}
+		if got.DaysOfWeek != 1<<1|1<<2|1<<3|1<<4|1<<5 {
+			t.Errorf("exp mon-fri, got %b", got.DaysOfWeek)
+		}
+	})
+}
+
+func TestParseWithOptionsSeconds(t *testing.T) {
+	t.Run("6 fields with seconds", func(t *testing.T) {
+		exp := &Schedule{
+			Seconds:     1 << 30,
+			Minutes:     1 << 0,
+			Hours:       1 << 1,
+			DaysOfMonth: 1 << 1,
+			Months:      1 << 1,
+			DaysOfWeek:  1 << 0,
+			Command:     "echo hi",
+		}
+		got, err := ParseWithOptions("30 0 1 1 1 0 echo hi", ParseOptions{Seconds: true})
+		if err != nil {
+			t.Fatalf("exp no err got %v", err)
+		}
+		if !reflect.DeepEqual(exp, got) {
+			t.Errorf("\nexp %v\ngot %v", exp, got)
+		}
+	})
+
+	t.Run("seconds out of range", func(t *testing.T) {
+		_, err := ParseWithOptions("60 0 1 1 1 0 cmd", ParseOptions{Seconds: true})
+		if err == nil {
+			t.Errorf("exp err got nil")
+		}
+	})
+
+	t.Run("Seconds option false keeps 5-field dialect", func(t *testing.T) {
+		got, err := ParseWithOptions("*/15 0 1,15 * 1-5 /usr/bin/find", ParseOptions{})
+		if err != nil {
+			t.Fatalf("exp no err got %v", err)
+		}
+		if got.Seconds != 0 {
+			t.Errorf("exp Seconds unset, got %v", got.Seconds)
+		}
+	})
+}
+
+func TestParseWithOptionsDescriptors(t *testing.T) {
+	const (
+		onlyBitZero = 1 << 0
+		everyHour   = (1 << 24) - 1
+		everyDom    = (1<<31 - 1) << 1
+		everyMonth  = (1<<12 - 1) << 1
+		everyDow    = (1 << 8) - 1
+	)
+
+	tt := []struct {
+		name string
+		exp  *Schedule
+	}{
+		{"@yearly", &Schedule{Seconds: onlyBitZero, Minutes: onlyBitZero, Hours: onlyBitZero, DaysOfMonth: 1 << 1, Months: 1 << 1, DaysOfWeek: everyDow, Command: "cmd", DaysOfWeekStar: true}},
+		{"@annually", &Schedule{Seconds: onlyBitZero, Minutes: onlyBitZero, Hours: onlyBitZero, DaysOfMonth: 1 << 1, Months: 1 << 1, DaysOfWeek: everyDow, Command: "cmd", DaysOfWeekStar: true}},
+		{"@monthly", &Schedule{Seconds: onlyBitZero, Minutes: onlyBitZero, Hours: onlyBitZero, DaysOfMonth: 1 << 1, Months: everyMonth, DaysOfWeek: everyDow, Command: "cmd", MonthsStar: true, DaysOfWeekStar: true}},
+		{"@weekly", &Schedule{Seconds: onlyBitZero, Minutes: onlyBitZero, Hours: onlyBitZero, DaysOfMonth: everyDom, Months: everyMonth, DaysOfWeek: 1 << 0, Command: "cmd", DaysOfMonthStar: true, MonthsStar: true}},
+		{"@daily", &Schedule{Seconds: onlyBitZero, Minutes: onlyBitZero, Hours: onlyBitZero, DaysOfMonth: everyDom, Months: everyMonth, DaysOfWeek: everyDow, Command: "cmd", DaysOfMonthStar: true, MonthsStar: true, DaysOfWeekStar: true}},
+		{"@midnight", &Schedule{Seconds: onlyBitZero, Minutes: onlyBitZero, Hours: onlyBitZero, DaysOfMonth: everyDom, Months: everyMonth, DaysOfWeek: everyDow, Command: "cmd", DaysOfMonthStar: true, MonthsStar: true, DaysOfWeekStar: true}},
+		{"@hourly", &Schedule{Seconds: onlyBitZero, Minutes: onlyBitZero, Hours: everyHour, DaysOfMonth: everyDom, Months: everyMonth, DaysOfWeek: everyDow, Command: "cmd", HoursStar: true, DaysOfMonthStar: true, MonthsStar: true, DaysOfWeekStar: true}},
+	}
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseWithOptions(tc.name+" cmd", ParseOptions{AllowDescriptors: true})
+			if err != nil {
+				t.Fatalf("exp no err got %v", err)
+			}
+			if !reflect.DeepEqual(tc.exp, got) {
+				t.Errorf("\nexp %v\ngot %v", tc.exp, got)
+			}
+		})
+	}
+
+	t.Run("@every parses as a duration-based schedule", func(t *testing.T) {
+		got, err := ParseWithOptions("@every 1h30m echo hi", ParseOptions{AllowDescriptors: true})
+		if err != nil {
+			t.Fatalf("exp no err got %v", err)
+		}
+		exp := &Schedule{Every: 90 * time.Minute, Command: "echo hi"}
+		if !reflect.DeepEqual(exp, got) {
+			t.Errorf("\nexp %v\ngot %v", exp, got)
+		}
+	})
+
+	t.Run("@every invalid duration", func(t *testing.T) {
+		_, err := ParseWithOptions("@every nope cmd", ParseOptions{AllowDescriptors: true})
+		if err == nil {
+			t.Errorf("exp err got nil")
+		}
+	})
+
+	t.Run("@every rejects a zero duration", func(t *testing.T) {
+		_, err := ParseWithOptions("@every 0s cmd", ParseOptions{AllowDescriptors: true})
+		if err == nil {
+			t.Errorf("exp err got nil")
+		}
+	})
+
+	t.Run("@every rejects a negative duration", func(t *testing.T) {
+		_, err := ParseWithOptions("@every -5m cmd", ParseOptions{AllowDescriptors: true})
+		if err == nil {
+			t.Errorf("exp err got nil")
+		}
+	})
+
+	t.Run("unknown descriptor", func(t *testing.T) {
+		_, err := ParseWithOptions("@fortnightly cmd", ParseOptions{AllowDescriptors: true})
+		if err == nil {
+			t.Errorf("exp err got nil")
+		}
+	})
+
+	t.Run("AllowDescriptors false leaves @ as a normal parse error", func(t *testing.T) {
+		_, err := ParseWithOptions("@yearly cmd", ParseOptions{})
+		if err == nil {
+			t.Errorf("exp err got nil")
+		}
+	})
+}
+
 func rangeToString(min, max uint8) string {
 	arr := make([]string, max-min+1)
 	for i := range arr {
@@ -179,7 +337,7 @@ func TestPrintMethodsAndTable(t *testing.T) {
 		{"day of month", schedule.PrintDaysOfMonth, frames["dayOfMonth"]},
 		{"month", schedule.PrintMonths, frames["month"]},
 		{"day of week", schedule.PrintDaysOfWeek, frames["dayOfWeek"]},
-		{"command", schedule.PrintCommand, frame{0, 0}},
+		{"command", schedule.PrintCommand, frame{min: 0, max: 0}},
 	}
 
 	var expBuf bytes.Buffer
@@ -0,0 +1,186 @@
+package parser
+
+import "time"
+
+// Next returns the earliest time strictly after t that satisfies the
+// schedule, evaluated in s.Location (time.Local if unset). It returns the
+// zero time.Time if no match is found within 5 years of t (e.g. a Feb 30
+// schedule).
+func (s *Schedule) Next(t time.Time) time.Time {
+	loc := s.location()
+	t = t.In(loc)
+
+	if s.Every > 0 {
+		return t.Add(s.Every)
+	}
+
+	t = t.Add(time.Second).Truncate(time.Second)
+	yearLimit := t.Year() + 5
+
+WRAP:
+	if t.Year() > yearLimit {
+		return time.Time{}
+	}
+
+	for !s.matchMonth(uint8(t.Month())) {
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc).AddDate(0, 1, 0)
+		if t.Year() > yearLimit {
+			return time.Time{}
+		}
+	}
+
+	for !s.matchDay(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).AddDate(0, 0, 1)
+		if t.Day() == 1 {
+			goto WRAP
+		}
+	}
+
+	if !s.matchHour(uint8(t.Hour())) {
+		// Truncate once, then step in absolute hours: rebuilding the time
+		// with time.Date on every step resolves a repeated fall-back hour
+		// to its first occurrence and would never advance past it.
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc)
+		for !s.matchHour(uint8(t.Hour())) {
+			t = t.Add(time.Hour)
+			if t.Hour() == 0 {
+				goto WRAP
+			}
+		}
+	}
+
+	for !s.matchMinute(uint8(t.Minute())) {
+		t = t.Truncate(time.Minute).Add(time.Minute)
+		if t.Minute() == 0 {
+			goto WRAP
+		}
+	}
+
+	for !s.matchSecond(uint8(t.Second())) {
+		t = t.Truncate(time.Second).Add(time.Second)
+		if t.Second() == 0 {
+			goto WRAP
+		}
+	}
+
+	return t
+}
+
+// Prev returns the latest time strictly before t that satisfies the
+// schedule, mirroring Next. It returns the zero time.Time if no match is
+// found within 5 years before t.
+func (s *Schedule) Prev(t time.Time) time.Time {
+	loc := s.location()
+	t = t.In(loc)
+
+	if s.Every > 0 {
+		return t.Add(-s.Every)
+	}
+
+	t = t.Add(-time.Second).Truncate(time.Second)
+	yearLimit := t.Year() - 5
+
+WRAP:
+	if t.Year() < yearLimit {
+		return time.Time{}
+	}
+
+	for !s.matchMonth(uint8(t.Month())) {
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, loc).Add(-time.Second)
+		if t.Year() < yearLimit {
+			return time.Time{}
+		}
+	}
+
+	for !s.matchDay(t) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, loc).Add(-time.Second)
+		if t.Day() == daysInMonth(t.Year(), t.Month()) {
+			goto WRAP
+		}
+	}
+
+	for !s.matchHour(uint8(t.Hour())) {
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, loc).Add(-time.Second)
+		if t.Hour() == 23 {
+			goto WRAP
+		}
+	}
+
+	for !s.matchMinute(uint8(t.Minute())) {
+		t = t.Truncate(time.Minute).Add(-time.Second)
+		if t.Minute() == 59 {
+			goto WRAP
+		}
+	}
+
+	for !s.matchSecond(uint8(t.Second())) {
+		t = t.Add(-time.Second)
+		if t.Second() == 59 {
+			goto WRAP
+		}
+	}
+
+	return t
+}
+
+func (s *Schedule) location() *time.Location {
+	if s.Location != nil {
+		return s.Location
+	}
+	return time.Local
+}
+
+func (s *Schedule) matchMonth(m uint8) bool {
+	return matchBit(s.Months, frames["month"], m)
+}
+
+func (s *Schedule) matchHour(h uint8) bool {
+	return matchBit(s.Hours, frames["hour"], h)
+}
+
+func (s *Schedule) matchMinute(m uint8) bool {
+	return matchBit(s.Minutes, frames["minute"], m)
+}
+
+func (s *Schedule) matchSecond(sec uint8) bool {
+	if s.Seconds == 0 {
+		return sec == 0
+	}
+	return matchBit(s.Seconds, frames["second"], sec)
+}
+
+// matchDay applies the standard cron OR-semantics: when both DaysOfMonth
+// and DaysOfWeek are restricted (i.e. neither was a bare "*" in the source),
+// a day matches if either bitset accepts it; otherwise only the restricted
+// field constrains the day.
+func (s *Schedule) matchDay(t time.Time) bool {
+	domOK := matchBit(s.DaysOfMonth, frames["dayOfMonth"], uint8(t.Day()))
+
+	wd := uint8(t.Weekday())
+	dowOK := matchBit(s.DaysOfWeek, frames["dayOfWeek"], wd)
+	if wd == 0 {
+		dowOK = dowOK || matchBit(s.DaysOfWeek, frames["dayOfWeek"], 7)
+	}
+
+	switch {
+	case s.DaysOfMonthStar && s.DaysOfWeekStar:
+		return true
+	case s.DaysOfMonthStar:
+		return dowOK
+	case s.DaysOfWeekStar:
+		return domOK
+	default:
+		return domOK || dowOK
+	}
+}
+
+func matchBit(val uint64, fr frame, pos uint8) bool {
+	if pos < fr.min || fr.max < pos {
+		return false
+	}
+	return (val>>pos)&1 != 0
+}
+
+func daysInMonth(year int, month time.Month) int {
+	return time.Date(year, month+1, 0, 0, 0, 0, 0, time.UTC).Day()
+}
@@ -0,0 +1,156 @@
+package parser
+
+import (
+	"testing"
+	"time"
+)
+
+func mustParse(t *testing.T, expr string, opts ParseOptions) *Schedule {
+	t.Helper()
+	sch, err := ParseWithOptions(expr, opts)
+	if err != nil {
+		t.Fatalf("ParseWithOptions(%q) failed: %v", expr, err)
+	}
+	return sch
+}
+
+func TestScheduleNext(t *testing.T) {
+	t.Run("every minute", func(t *testing.T) {
+		sch := mustParse(t, "* * * * * cmd", ParseOptions{})
+		from := time.Date(2026, time.March, 1, 10, 30, 15, 0, time.UTC)
+		exp := time.Date(2026, time.March, 1, 10, 31, 0, 0, time.UTC)
+		got := sch.Next(from)
+		if !got.Equal(exp) {
+			t.Errorf("exp %v got %v", exp, got)
+		}
+	})
+
+	t.Run("specific minute rolls to next matching day", func(t *testing.T) {
+		sch := mustParse(t, "0 9 1 * * cmd", ParseOptions{})
+		from := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+		exp := time.Date(2026, time.April, 1, 9, 0, 0, 0, time.UTC)
+		got := sch.Next(from)
+		if !got.Equal(exp) {
+			t.Errorf("exp %v got %v", exp, got)
+		}
+	})
+
+	t.Run("leap year Feb 29", func(t *testing.T) {
+		sch := mustParse(t, "0 0 29 2 * cmd", ParseOptions{})
+		from := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+		exp := time.Date(2028, time.February, 29, 0, 0, 0, 0, time.UTC)
+		got := sch.Next(from)
+		if !got.Equal(exp) {
+			t.Errorf("exp %v got %v", exp, got)
+		}
+	})
+
+	t.Run("impossible schedule returns zero time", func(t *testing.T) {
+		sch := mustParse(t, "0 0 30 2 * cmd", ParseOptions{})
+		from := time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)
+		got := sch.Next(from)
+		if !got.IsZero() {
+			t.Errorf("exp zero time, got %v", got)
+		}
+	})
+
+	t.Run("either DaysOfMonth or DaysOfWeek matches when both restricted", func(t *testing.T) {
+		sch := mustParse(t, "0 0 1 * mon cmd", ParseOptions{})
+		from := time.Date(2026, time.March, 2, 0, 0, 0, 0, time.UTC) // a Monday, not the 1st
+		exp := time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC) // next Monday
+		got := sch.Next(from)
+		if !got.Equal(exp) {
+			t.Errorf("exp %v got %v", exp, got)
+		}
+	})
+
+	t.Run("@every uses the fixed interval", func(t *testing.T) {
+		sch := mustParse(t, "@every 1h30m cmd", ParseOptions{AllowDescriptors: true})
+		from := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+		exp := time.Date(2026, time.March, 1, 1, 30, 0, 0, time.UTC)
+		got := sch.Next(from)
+		if !got.Equal(exp) {
+			t.Errorf("exp %v got %v", exp, got)
+		}
+	})
+
+	t.Run("Location pins evaluation to a timezone", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skipf("tzdata unavailable: %v", err)
+		}
+		sch := mustParse(t, "0 9 * * * cmd", ParseOptions{})
+		sch.Location = loc
+		from := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+		got := sch.Next(from)
+		if got.Location() != loc {
+			t.Errorf("exp location %v, got %v", loc, got.Location())
+		}
+		if got.Hour() != 9 {
+			t.Errorf("exp 9 AM in %v, got %v", loc, got)
+		}
+	})
+
+	t.Run("spring-forward: a skipped wall-clock minute fires the next day", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skipf("tzdata unavailable: %v", err)
+		}
+		// Clocks jump from 01:59:59 to 03:00:00 on 2026-03-08 in America/New_York.
+		sch := mustParse(t, "30 2 * * * cmd", ParseOptions{})
+		sch.Location = loc
+		from := time.Date(2026, time.March, 8, 1, 0, 0, 0, loc)
+		got := sch.Next(from)
+		if got.Day() != 9 || got.Hour() != 2 || got.Minute() != 30 {
+			t.Errorf("exp next day at 02:30 (2:30 AM doesn't exist on the 8th), got %v", got)
+		}
+	})
+
+	t.Run("fall-back: the doubled wall-clock hour is still reached", func(t *testing.T) {
+		loc, err := time.LoadLocation("America/New_York")
+		if err != nil {
+			t.Skipf("tzdata unavailable: %v", err)
+		}
+		// Clocks fall back from 01:59:59 EDT to 01:00:00 EST on 2026-11-01
+		// in America/New_York, so local 01:00 occurs twice that day.
+		sch := mustParse(t, "0 1 * * * cmd", ParseOptions{})
+		sch.Location = loc
+		from := time.Date(2026, time.October, 31, 1, 0, 1, 0, loc)
+		got := sch.Next(from)
+		if got.Day() != 1 || got.Month() != time.November || got.Hour() != 1 || got.Minute() != 0 {
+			t.Errorf("exp 2026-11-01 01:00, got %v", got)
+		}
+	})
+}
+
+func TestSchedulePrev(t *testing.T) {
+	t.Run("every minute", func(t *testing.T) {
+		sch := mustParse(t, "* * * * * cmd", ParseOptions{})
+		from := time.Date(2026, time.March, 1, 10, 30, 15, 0, time.UTC)
+		exp := time.Date(2026, time.March, 1, 10, 30, 0, 0, time.UTC)
+		got := sch.Prev(from)
+		if !got.Equal(exp) {
+			t.Errorf("exp %v got %v", exp, got)
+		}
+	})
+
+	t.Run("specific minute rolls to previous matching day", func(t *testing.T) {
+		sch := mustParse(t, "0 9 1 * * cmd", ParseOptions{})
+		from := time.Date(2026, time.March, 1, 9, 0, 0, 0, time.UTC)
+		exp := time.Date(2026, time.February, 1, 9, 0, 0, 0, time.UTC)
+		got := sch.Prev(from)
+		if !got.Equal(exp) {
+			t.Errorf("exp %v got %v", exp, got)
+		}
+	})
+
+	t.Run("Next and Prev are inverse across a boundary", func(t *testing.T) {
+		sch := mustParse(t, "0 0 1 * * cmd", ParseOptions{})
+		from := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+		next := sch.Next(from)
+		back := sch.Prev(next)
+		if !back.Equal(from) {
+			t.Errorf("exp %v got %v", from, back)
+		}
+	})
+}
@@ -0,0 +1,284 @@
+// Package runner dispatches Jobs on the fire times computed by
+// parser.Schedule.Next.
+package runner
+
+import (
+	"container/heap"
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/smnalex/cron/parser"
+)
+
+// Job is the unit of work dispatched when an entry's schedule fires.
+type Job func(time.Time) error
+
+// Logger is the minimal logging surface Cron depends on.
+type Logger interface {
+	Printf(format string, args ...interface{})
+}
+
+// ErrorHandler is invoked whenever a Job returns an error.
+type ErrorHandler func(name string, err error)
+
+// Option configures a Cron at construction time.
+type Option func(*Cron)
+
+// WithLogger sets the logger used for dispatch errors. The default is a
+// no-op logger.
+func WithLogger(l Logger) Option {
+	return func(c *Cron) { c.logger = l }
+}
+
+// WithErrorHandler sets the callback invoked when a Job returns an error.
+// The default logs the error via the configured Logger.
+func WithErrorHandler(h ErrorHandler) Option {
+	return func(c *Cron) { c.errorHandler = h }
+}
+
+// withNow overrides the clock Cron uses to compute fire times. It is
+// unexported and only meant for this package's own tests.
+func withNow(now func() time.Time) Option {
+	return func(c *Cron) { c.now = now }
+}
+
+type entry struct {
+	id   int
+	name string
+	sch  *parser.Schedule
+	job  Job
+	next time.Time
+
+	mu      sync.Mutex
+	prev    time.Time
+	lastErr error
+}
+
+// Cron dispatches Jobs on their own goroutine as their schedules fire. The
+// zero value is not usable; construct one with New.
+type Cron struct {
+	mu      sync.Mutex
+	entries map[int]*entry
+	queue   *entryHeap
+	nextID  int
+	done    chan struct{}
+
+	logger       Logger
+	errorHandler ErrorHandler
+	now          func() time.Time
+	wake         chan struct{}
+}
+
+// New creates a Cron ready to accept schedules via AddSchedule.
+func New(opts ...Option) *Cron {
+	c := &Cron{
+		entries: make(map[int]*entry),
+		queue:   &entryHeap{},
+		logger:  noopLogger{},
+		now:     time.Now,
+		wake:    make(chan struct{}, 1),
+	}
+	c.errorHandler = func(name string, err error) {
+		c.logger.Printf("runner: entry %q failed: %v", name, err)
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// AddSchedule registers j to run on s, naming the entry name for logging
+// and error reporting, and returns its id.
+func (c *Cron) AddSchedule(name string, s *parser.Schedule, j Job) (int, error) {
+	if s == nil {
+		return 0, fmt.Errorf("runner: nil schedule for %q", name)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.nextID++
+	id := c.nextID
+	e := &entry{id: id, name: name, sch: s, job: j, next: s.Next(c.now())}
+	c.entries[id] = e
+	if !e.next.IsZero() {
+		heap.Push(c.queue, &heapItem{next: e.next, id: id})
+	}
+	c.wakeLocked()
+
+	return id, nil
+}
+
+// Remove cancels entry id; it is a no-op if id is unknown.
+func (c *Cron) Remove(id int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, id)
+	c.wakeLocked()
+}
+
+// Prev returns the last time entry id fired, and false if it hasn't fired
+// yet or id is unknown.
+func (c *Cron) Prev(id int) (time.Time, bool) {
+	e, ok := c.entry(id)
+	if !ok {
+		return time.Time{}, false
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.prev, !e.prev.IsZero()
+}
+
+// Next returns the scheduled next fire time for entry id, and false if id
+// is unknown.
+func (c *Cron) Next(id int) (time.Time, bool) {
+	e, ok := c.entry(id)
+	if !ok {
+		return time.Time{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return e.next, true
+}
+
+// LastError returns the error returned by the most recent run of entry id,
+// if any.
+func (c *Cron) LastError(id int) error {
+	e, ok := c.entry(id)
+	if !ok {
+		return nil
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return e.lastErr
+}
+
+func (c *Cron) entry(id int) (*entry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[id]
+	return e, ok
+}
+
+func (c *Cron) wakeLocked() {
+	select {
+	case c.wake <- struct{}{}:
+	default:
+	}
+}
+
+// Start runs the dispatch loop until ctx is done or Stop is called; it
+// blocks the calling goroutine, so callers typically run it with `go`.
+func (c *Cron) Start(ctx context.Context) {
+	c.mu.Lock()
+	c.done = make(chan struct{})
+	c.mu.Unlock()
+
+	for {
+		var timer <-chan time.Time
+		c.mu.Lock()
+		if c.queue.Len() > 0 {
+			d := (*c.queue)[0].next.Sub(c.now())
+			if d < 0 {
+				d = 0
+			}
+			timer = time.After(d)
+		}
+		c.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-c.done:
+			return
+		case <-c.wake:
+		case <-timer:
+			c.dispatchDue()
+		}
+	}
+}
+
+// Stop terminates the dispatch loop started by Start.
+func (c *Cron) Stop() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.done == nil {
+		return
+	}
+	select {
+	case <-c.done:
+	default:
+		close(c.done)
+	}
+}
+
+func (c *Cron) dispatchDue() {
+	now := c.now()
+
+	c.mu.Lock()
+	var due []*entry
+	for c.queue.Len() > 0 && !(*c.queue)[0].next.After(now) {
+		item := heap.Pop(c.queue).(*heapItem)
+		e, ok := c.entries[item.id]
+		if !ok {
+			continue
+		}
+		due = append(due, e)
+	}
+	c.mu.Unlock()
+
+	for _, e := range due {
+		fireAt := e.next
+		go c.dispatch(e, fireAt)
+
+		next := e.sch.Next(now)
+		c.mu.Lock()
+		e.next = next
+		if _, ok := c.entries[e.id]; ok && !next.IsZero() {
+			heap.Push(c.queue, &heapItem{next: next, id: e.id})
+		}
+		c.mu.Unlock()
+	}
+}
+
+func (c *Cron) dispatch(e *entry, firedAt time.Time) {
+	err := e.job(firedAt)
+
+	e.mu.Lock()
+	e.prev = firedAt
+	e.lastErr = err
+	e.mu.Unlock()
+
+	if err != nil {
+		c.errorHandler(e.name, err)
+	}
+}
+
+type noopLogger struct{}
+
+func (noopLogger) Printf(string, ...interface{}) {}
+
+type heapItem struct {
+	next time.Time
+	id   int
+}
+
+type entryHeap []*heapItem
+
+func (h entryHeap) Len() int           { return len(h) }
+func (h entryHeap) Less(i, j int) bool { return h[i].next.Before(h[j].next) }
+func (h entryHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *entryHeap) Push(x interface{}) {
+	*h = append(*h, x.(*heapItem))
+}
+
+func (h *entryHeap) Pop() interface{} {
+	old := *h
+	n := len(old)
+	item := old[n-1]
+	*h = old[:n-1]
+	return item
+}
@@ -0,0 +1,200 @@
+package runner
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/smnalex/cron/parser"
+)
+
+func mustSchedule(t *testing.T, expr string) *parser.Schedule {
+	t.Helper()
+	sch, err := parser.ParseWithOptions(expr, parser.ParseOptions{AllowDescriptors: true})
+	if err != nil {
+		t.Fatalf("ParseWithOptions(%q) failed: %v", expr, err)
+	}
+	return sch
+}
+
+func TestCronAddScheduleComputesNext(t *testing.T) {
+	fixedNow := time.Date(2026, time.March, 1, 8, 0, 0, 0, time.UTC)
+	c := New(withNow(func() time.Time { return fixedNow }))
+
+	sch := mustSchedule(t, "0 9 * * * cmd")
+	id, err := c.AddSchedule("daily-9am", sch, func(time.Time) error { return nil })
+	if err != nil {
+		t.Fatalf("AddSchedule failed: %v", err)
+	}
+
+	got, ok := c.Next(id)
+	if !ok {
+		t.Fatalf("exp Next to report the entry exists")
+	}
+	if exp := sch.Next(fixedNow); !got.Equal(exp) {
+		t.Errorf("exp %v got %v", exp, got)
+	}
+
+	if _, ok := c.Prev(id); ok {
+		t.Errorf("exp Prev to report no run yet")
+	}
+	if err := c.LastError(id); err != nil {
+		t.Errorf("exp no error before first run, got %v", err)
+	}
+}
+
+func TestCronAddScheduleRejectsNilSchedule(t *testing.T) {
+	c := New()
+	if _, err := c.AddSchedule("bad", nil, func(time.Time) error { return nil }); err == nil {
+		t.Errorf("exp err for nil schedule, got nil")
+	}
+}
+
+// TestCronAddScheduleRetiresImpossibleSchedule guards against a busy-loop:
+// a schedule whose Next never matches (e.g. Feb 30) returns the zero
+// time.Time, which must not be queued as an imminent fire time.
+func TestCronAddScheduleRetiresImpossibleSchedule(t *testing.T) {
+	c := New()
+	sch := mustSchedule(t, "0 0 30 2 * cmd")
+
+	fired := make(chan struct{}, 1)
+	id, err := c.AddSchedule("impossible", sch, func(time.Time) error {
+		select {
+		case fired <- struct{}{}:
+		default:
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("AddSchedule failed: %v", err)
+	}
+
+	if got, ok := c.Next(id); !ok || !got.IsZero() {
+		t.Fatalf("exp zero next time for an unreachable schedule, got %v ok=%v", got, ok)
+	}
+	if c.queue.Len() != 0 {
+		t.Fatalf("exp the unreachable entry to never be queued, queue len %d", c.queue.Len())
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+	defer c.Stop()
+
+	select {
+	case <-fired:
+		t.Fatal("exp an unreachable schedule to never fire")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
+
+func TestCronDispatch(t *testing.T) {
+	c := New()
+	sch := mustSchedule(t, "@every 20ms")
+
+	fired := make(chan time.Time, 8)
+	id, err := c.AddSchedule("tick", sch, func(at time.Time) error {
+		fired <- at
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("AddSchedule failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+	defer c.Stop()
+
+	select {
+	case <-fired:
+	case <-time.After(time.Second):
+		t.Fatal("exp job to fire within 1s")
+	}
+
+	// Allow the dispatch goroutine to record prev/lastErr before reading.
+	deadline := time.Now().Add(time.Second)
+	for {
+		if _, ok := c.Prev(id); ok {
+			break
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("exp Prev to be set after a run")
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if err := c.LastError(id); err != nil {
+		t.Errorf("exp no error, got %v", err)
+	}
+}
+
+func TestCronErrorHandler(t *testing.T) {
+	var mu sync.Mutex
+	var got error
+	handled := make(chan struct{}, 1)
+
+	c := New(WithErrorHandler(func(name string, err error) {
+		mu.Lock()
+		got = err
+		mu.Unlock()
+		select {
+		case handled <- struct{}{}:
+		default:
+		}
+	}))
+
+	sch := mustSchedule(t, "@every 20ms")
+	if _, err := c.AddSchedule("failing", sch, func(time.Time) error { return errBoom }); err != nil {
+		t.Fatalf("AddSchedule failed: %v", err)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+	defer c.Stop()
+
+	select {
+	case <-handled:
+	case <-time.After(time.Second):
+		t.Fatal("exp error handler to run within 1s")
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if got != errBoom {
+		t.Errorf("exp %v got %v", errBoom, got)
+	}
+}
+
+func TestCronRemove(t *testing.T) {
+	c := New()
+	sch := mustSchedule(t, "@every 10ms")
+
+	fired := make(chan struct{}, 8)
+	id, err := c.AddSchedule("tick", sch, func(time.Time) error {
+		fired <- struct{}{}
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("AddSchedule failed: %v", err)
+	}
+	c.Remove(id)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go c.Start(ctx)
+	defer c.Stop()
+
+	select {
+	case <-fired:
+		t.Fatal("exp removed entry to never fire")
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+type boomError struct{}
+
+func (boomError) Error() string { return "boom" }
+
+var errBoom error = boomError{}